@@ -0,0 +1,145 @@
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// BigFloatTypable extends attr.Type for arbitrary-precision floating point
+// types. Implement this interface to create a custom BigFloatType type.
+type BigFloatTypable interface {
+	xattr.TypeWithValidate
+
+	// ValueFromBigFloat should convert the BigFloat to a BigFloatValuable type.
+	ValueFromBigFloat(context.Context, BigFloatValue) (BigFloatValuable, diag.Diagnostics)
+}
+
+var _ BigFloatTypable = BigFloatType{}
+
+// BigFloatType is the base framework type for an arbitrary-precision floating
+// point number. Unlike Float64Type, it does not require that values be
+// representable as a 64-bit float. BigFloatValue is the associated value
+// type.
+//
+// The precision and rounding mode are optional; when unset, values retain
+// whatever precision tftypes reports them with.
+type BigFloatType struct {
+	precision uint
+	mode      big.RoundingMode
+	modeSet   bool
+}
+
+// WithPrecision returns a copy of the type that rounds known values to the
+// given precision (in mantissa bits) when converting from Terraform.
+func (t BigFloatType) WithPrecision(precision uint) BigFloatType {
+	t.precision = precision
+	return t
+}
+
+// WithRoundingMode returns a copy of the type that rounds known values using
+// the given big.RoundingMode when a precision is also set.
+func (t BigFloatType) WithRoundingMode(mode big.RoundingMode) BigFloatType {
+	t.mode = mode
+	t.modeSet = true
+	return t
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t BigFloatType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is equivalent.
+func (t BigFloatType) Equal(o attr.Type) bool {
+	other, ok := o.(BigFloatType)
+
+	if !ok {
+		return false
+	}
+
+	return t.precision == other.precision && t.mode == other.mode && t.modeSet == other.modeSet
+}
+
+// String returns a human readable string of the type name.
+func (t BigFloatType) String() string {
+	return "basetypes.BigFloatType"
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// framework type.
+func (t BigFloatType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.Number
+}
+
+// Validate implements type validation. Unlike Float64Type, any Number value
+// is accepted regardless of whether it is representable as a 64-bit float.
+func (t BigFloatType) Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.Number) {
+		diags.AddAttributeError(
+			path,
+			"BigFloat Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected Number value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// ValueFromBigFloat returns a BigFloatValuable type given a BigFloatValue.
+func (t BigFloatType) ValueFromBigFloat(_ context.Context, v BigFloatValue) (BigFloatValuable, diag.Diagnostics) {
+	return v, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. The *big.Float
+// produced by in.As is kept untouched unless a precision has been configured
+// on the type, so arbitrary-precision values round-trip without narrowing.
+func (t BigFloatType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return NewBigFloatUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewBigFloatNull(), nil
+	}
+
+	var bigF *big.Float
+	err := in.As(&bigF)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if t.precision != 0 {
+		rounded := new(big.Float).SetPrec(t.precision)
+
+		if t.modeSet {
+			rounded.SetMode(t.mode)
+		}
+
+		bigF = rounded.Set(bigF)
+	}
+
+	return NewBigFloatValue(bigF), nil
+}
+
+// ValueType returns the Value type.
+func (t BigFloatType) ValueType(_ context.Context) attr.Value {
+	// This Value does not need to be valid.
+	return BigFloatValue{}
+}
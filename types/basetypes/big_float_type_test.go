@@ -0,0 +1,169 @@
+package basetypes_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBigFloatTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver attr.Type
+		input    attr.Type
+		expected bool
+	}{
+		"wrong-type": {
+			receiver: basetypes.BigFloatType{},
+			input:    basetypes.Float64Type{},
+			expected: false,
+		},
+		"equal": {
+			receiver: basetypes.BigFloatType{},
+			input:    basetypes.BigFloatType{},
+			expected: true,
+		},
+		"different-precision": {
+			receiver: basetypes.BigFloatType{}.WithPrecision(53),
+			input:    basetypes.BigFloatType{}.WithPrecision(100),
+			expected: false,
+		},
+		"different-rounding-mode": {
+			receiver: basetypes.BigFloatType{}.WithRoundingMode(big.ToNearestEven),
+			input:    basetypes.BigFloatType{}.WithRoundingMode(big.ToZero),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.receiver.Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestBigFloatTypeString(t *testing.T) {
+	t.Parallel()
+
+	got := basetypes.BigFloatType{}.String()
+	expected := "basetypes.BigFloatType"
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestBigFloatTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val         tftypes.Value
+		expectError bool
+	}{
+		"null": {
+			val: tftypes.NewValue(tftypes.Number, nil),
+		},
+		"unknown": {
+			val: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		},
+		"beyond-float64-precision": {
+			val: tftypes.NewValue(tftypes.Number, new(big.Float).SetPrec(200).SetInt(
+				new(big.Int).Exp(big.NewInt(10), big.NewInt(400), nil),
+			)),
+		},
+		"wrong-type": {
+			val:         tftypes.NewValue(tftypes.String, "1"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := basetypes.BigFloatType{}.Validate(context.Background(), testCase.val, path.Root("test"))
+
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", testCase.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestBigFloatTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	hugeVal := new(big.Float).SetPrec(200).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(400), nil))
+
+	testCases := map[string]struct {
+		typ      basetypes.BigFloatType
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"null": {
+			typ:      basetypes.BigFloatType{},
+			val:      tftypes.NewValue(tftypes.Number, nil),
+			expected: basetypes.NewBigFloatNull(),
+		},
+		"unknown": {
+			typ:      basetypes.BigFloatType{},
+			val:      tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expected: basetypes.NewBigFloatUnknown(),
+		},
+		"beyond-float64-precision-untouched": {
+			typ:      basetypes.BigFloatType{},
+			val:      tftypes.NewValue(tftypes.Number, hugeVal),
+			expected: basetypes.NewBigFloatValue(hugeVal),
+		},
+		"precision-applied": {
+			typ:      basetypes.BigFloatType{}.WithPrecision(24),
+			val:      tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+			expected: basetypes.NewBigFloatValue(new(big.Float).SetPrec(24).Set(big.NewFloat(1.5))),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.val)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBigFloatTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	got := basetypes.BigFloatType{}.ValueType(context.Background())
+
+	if _, ok := got.(basetypes.BigFloatValue); !ok {
+		t.Errorf("expected basetypes.BigFloatValue, got %T", got)
+	}
+}
@@ -0,0 +1,133 @@
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// BigFloatValuable extends attr.Value for types that can be converted to
+// BigFloatValue.
+type BigFloatValuable interface {
+	attr.Value
+
+	// ToBigFloatValue should convert the value type to a BigFloatValue.
+	ToBigFloatValue(ctx context.Context) (BigFloatValue, diag.Diagnostics)
+}
+
+var _ BigFloatValuable = BigFloatValue{}
+
+// NewBigFloatNull creates a BigFloatValue with a null value. Determine whether
+// the value is null via the BigFloatValue type IsNull method.
+func NewBigFloatNull() BigFloatValue {
+	return BigFloatValue{state: attr.ValueStateNull}
+}
+
+// NewBigFloatUnknown creates a BigFloatValue with an unknown value. Determine
+// whether the value is unknown via the BigFloatValue type IsUnknown method.
+func NewBigFloatUnknown() BigFloatValue {
+	return BigFloatValue{state: attr.ValueStateUnknown}
+}
+
+// NewBigFloatValue creates a BigFloatValue with a known value. Access the
+// value via the BigFloatValue type ValueBigFloat method. The given *big.Float
+// is retained as-is; it is never narrowed to a float64. A nil value is
+// treated as null, mirroring NewFloat64PointerValue.
+func NewBigFloatValue(value *big.Float) BigFloatValue {
+	if value == nil {
+		return NewBigFloatNull()
+	}
+
+	return BigFloatValue{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// BigFloatValue represents an arbitrary-precision floating point value, which
+// can be null, unknown, or a known *big.Float value.
+type BigFloatValue struct {
+	state attr.ValueState
+	value *big.Float
+}
+
+// Type returns a BigFloatType.
+func (v BigFloatValue) Type(_ context.Context) attr.Type {
+	return BigFloatType{}
+}
+
+// ToTerraformValue returns the data contained in the BigFloatValue as a
+// tftypes.Value.
+func (v BigFloatValue) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	switch v.state {
+	case attr.ValueStateKnown:
+		if err := tftypes.ValidateValue(tftypes.Number, v.value); err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.Number, v.value), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tftypes.Number, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled BigFloatValue state in ToTerraformValue: %s", v.state))
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v BigFloatValue) Equal(o attr.Value) bool {
+	other, ok := o.(BigFloatValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return v.value.Cmp(other.value) == 0
+}
+
+// IsNull returns true if the Value is not set, or is explicitly set to null.
+func (v BigFloatValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Value is not yet known.
+func (v BigFloatValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+// String returns a human readable representation of the value.
+func (v BigFloatValue) String() string {
+	if v.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if v.IsNull() {
+		return attr.NullValueString
+	}
+
+	return v.value.Text('g', -1)
+}
+
+// ValueBigFloat returns the known *big.Float value. If BigFloatValue is null
+// or unknown, returns nil.
+func (v BigFloatValue) ValueBigFloat() *big.Float {
+	return v.value
+}
+
+// ToBigFloatValue returns BigFloatValue.
+func (v BigFloatValue) ToBigFloatValue(_ context.Context) (BigFloatValue, diag.Diagnostics) {
+	return v, nil
+}
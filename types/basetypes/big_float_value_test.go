@@ -0,0 +1,153 @@
+package basetypes_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestBigFloatValueEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver basetypes.BigFloatValue
+		input    attr.Value
+		expected bool
+	}{
+		"wrong-type": {
+			receiver: basetypes.NewBigFloatValue(big.NewFloat(1.5)),
+			input:    basetypes.NewFloat64Value(1.5),
+			expected: false,
+		},
+		"null-null": {
+			receiver: basetypes.NewBigFloatNull(),
+			input:    basetypes.NewBigFloatNull(),
+			expected: true,
+		},
+		"unknown-unknown": {
+			receiver: basetypes.NewBigFloatUnknown(),
+			input:    basetypes.NewBigFloatUnknown(),
+			expected: true,
+		},
+		"known-known-equal": {
+			receiver: basetypes.NewBigFloatValue(big.NewFloat(1.5)),
+			input:    basetypes.NewBigFloatValue(big.NewFloat(1.5)),
+			expected: true,
+		},
+		"known-known-different-precision-same-value": {
+			receiver: basetypes.NewBigFloatValue(new(big.Float).SetPrec(200).SetFloat64(1.5)),
+			input:    basetypes.NewBigFloatValue(big.NewFloat(1.5)),
+			expected: true,
+		},
+		"known-known-different": {
+			receiver: basetypes.NewBigFloatValue(big.NewFloat(1.5)),
+			input:    basetypes.NewBigFloatValue(big.NewFloat(2.5)),
+			expected: false,
+		},
+		"known-null": {
+			receiver: basetypes.NewBigFloatValue(big.NewFloat(1.5)),
+			input:    basetypes.NewBigFloatNull(),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.receiver.Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestBigFloatValueIsNull(t *testing.T) {
+	t.Parallel()
+
+	if !basetypes.NewBigFloatNull().IsNull() {
+		t.Error("expected null value to return true")
+	}
+
+	if basetypes.NewBigFloatValue(big.NewFloat(1.5)).IsNull() {
+		t.Error("expected known value to return false")
+	}
+}
+
+func TestBigFloatValueIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	if !basetypes.NewBigFloatUnknown().IsUnknown() {
+		t.Error("expected unknown value to return true")
+	}
+
+	if basetypes.NewBigFloatValue(big.NewFloat(1.5)).IsUnknown() {
+		t.Error("expected known value to return false")
+	}
+}
+
+func TestBigFloatValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val      basetypes.BigFloatValue
+		expected string
+	}{
+		"null":    {val: basetypes.NewBigFloatNull(), expected: "<null>"},
+		"unknown": {val: basetypes.NewBigFloatUnknown(), expected: "<unknown>"},
+		"known":   {val: basetypes.NewBigFloatValue(big.NewFloat(1.5)), expected: "1.5"},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.val.String()
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestBigFloatValueValueBigFloat(t *testing.T) {
+	t.Parallel()
+
+	value := big.NewFloat(1.5)
+
+	got := basetypes.NewBigFloatValue(value).ValueBigFloat()
+
+	if got.Cmp(value) != 0 {
+		t.Errorf("expected %s, got %s", value, got)
+	}
+
+	if basetypes.NewBigFloatNull().ValueBigFloat() != nil {
+		t.Error("expected null value to return nil")
+	}
+}
+
+func TestBigFloatValueToBigFloatValue(t *testing.T) {
+	t.Parallel()
+
+	value := basetypes.NewBigFloatValue(big.NewFloat(1.5))
+
+	got, diags := value.ToBigFloatValue(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if !got.Equal(value) {
+		t.Errorf("expected %s, got %s", value, got)
+	}
+}
@@ -0,0 +1,159 @@
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ Float64Typable = Float64RangeType{}
+
+// Float64RangeType is a Float64Type that additionally enforces a minimum and
+// maximum bound on known values. It allows providers to declare bounded
+// floating point attributes through the type system instead of attaching a
+// separate Between-style validator to every matching attribute.
+type Float64RangeType struct {
+	Float64Type
+
+	min, max  float64
+	inclusive bool
+}
+
+// NewFloat64TypeWithRange returns a Float64Typable that only accepts known
+// values within [min, max] when inclusive is true, or (min, max) when
+// inclusive is false.
+func NewFloat64TypeWithRange(min, max float64, inclusive bool) Float64RangeType {
+	return Float64RangeType{
+		min:       min,
+		max:       max,
+		inclusive: inclusive,
+	}
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t Float64RangeType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Float64RangeType) Equal(o attr.Type) bool {
+	other, ok := o.(Float64RangeType)
+
+	if !ok {
+		return false
+	}
+
+	if !t.Float64Type.Equal(other.Float64Type) {
+		return false
+	}
+
+	return t.min == other.min && t.max == other.max && t.inclusive == other.inclusive
+}
+
+// String returns a human readable string of the type name.
+func (t Float64RangeType) String() string {
+	return fmt.Sprintf("basetypes.Float64RangeType[%v,%v]", t.min, t.max)
+}
+
+// Validate implements type validation, additionally rejecting known values
+// that fall outside the configured range.
+func (t Float64RangeType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	diags := t.Float64Type.Validate(ctx, in, path)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value *big.Float
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Float64 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to big.Float: %s", err),
+		)
+		return diags
+	}
+
+	f, _ := value.Float64()
+
+	if !t.inRange(f) {
+		diags.AddAttributeError(
+			path,
+			"Float64 Range Validation Error",
+			t.rangeErrorDetail(f),
+		)
+	}
+
+	return diags
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value, enforcing the
+// configured range so that downstream code can trust known Float64Values
+// always satisfy the bounds.
+func (t Float64RangeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.Float64Type.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f64, ok := v.(Float64Value)
+
+	if !ok || f64.IsNull() || f64.IsUnknown() {
+		return v, nil
+	}
+
+	if !t.inRange(f64.ValueFloat64()) {
+		return nil, fmt.Errorf("%s", t.rangeErrorDetail(f64.ValueFloat64()))
+	}
+
+	return v, nil
+}
+
+// ValueFromFloat64 returns a Float64Valuable type given a Float64Value,
+// rejecting values that fall outside the configured range so the bound
+// cannot be bypassed through this construction path.
+func (t Float64RangeType) ValueFromFloat64(ctx context.Context, v Float64Value) (Float64Valuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !v.IsNull() && !v.IsUnknown() && !t.inRange(v.ValueFloat64()) {
+		diags.AddError(
+			"Float64 Range Validation Error",
+			t.rangeErrorDetail(v.ValueFloat64()),
+		)
+		return nil, diags
+	}
+
+	return v, diags
+}
+
+// ValueType returns the Value type.
+func (t Float64RangeType) ValueType(_ context.Context) attr.Value {
+	return Float64Value{}
+}
+
+func (t Float64RangeType) inRange(f float64) bool {
+	if t.inclusive {
+		return f >= t.min && f <= t.max
+	}
+
+	return f > t.min && f < t.max
+}
+
+// rangeErrorDetail returns the shared diagnostic message used by Validate,
+// ValueFromTerraform, and ValueFromFloat64 so all three enforcement paths
+// report identical wording for the same out-of-range value.
+func (t Float64RangeType) rangeErrorDetail(f float64) string {
+	return fmt.Sprintf("Value must be between %v and %v (inclusive: %t), got: %v.", t.min, t.max, t.inclusive, f)
+}
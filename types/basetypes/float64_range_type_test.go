@@ -0,0 +1,263 @@
+package basetypes_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// differentOptionsFloat64RangeType returns a Float64RangeType with the same
+// bounds as NewFloat64TypeWithRange(0, 1, true) but a different embedded
+// Float64Type precision-loss policy, to verify Equal accounts for the
+// embedded Float64Type and not just the range-specific fields.
+func differentOptionsFloat64RangeType() basetypes.Float64RangeType {
+	rangeType := basetypes.NewFloat64TypeWithRange(0, 1, true)
+	rangeType.Float64Type = basetypes.NewFloat64TypeWithOptions(basetypes.Float64TypeOptions{OnPrecisionLoss: basetypes.PrecisionLossWarn})
+
+	return rangeType
+}
+
+func TestFloat64RangeTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver basetypes.Float64RangeType
+		input    attr.Type
+		expected bool
+	}{
+		"wrong-type": {
+			receiver: basetypes.NewFloat64TypeWithRange(0, 1, true),
+			input:    basetypes.Float64Type{},
+			expected: false,
+		},
+		"equal": {
+			receiver: basetypes.NewFloat64TypeWithRange(0, 1, true),
+			input:    basetypes.NewFloat64TypeWithRange(0, 1, true),
+			expected: true,
+		},
+		"different-min": {
+			receiver: basetypes.NewFloat64TypeWithRange(0, 1, true),
+			input:    basetypes.NewFloat64TypeWithRange(0.5, 1, true),
+			expected: false,
+		},
+		"different-max": {
+			receiver: basetypes.NewFloat64TypeWithRange(0, 1, true),
+			input:    basetypes.NewFloat64TypeWithRange(0, 2, true),
+			expected: false,
+		},
+		"different-inclusive": {
+			receiver: basetypes.NewFloat64TypeWithRange(0, 1, true),
+			input:    basetypes.NewFloat64TypeWithRange(0, 1, false),
+			expected: false,
+		},
+		"different-embedded-float64type-options": {
+			receiver: basetypes.NewFloat64TypeWithRange(0, 1, true),
+			input:    differentOptionsFloat64RangeType(),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.receiver.Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFloat64RangeTypeString(t *testing.T) {
+	t.Parallel()
+
+	got := basetypes.NewFloat64TypeWithRange(0, 1, true).String()
+	expected := "basetypes.Float64RangeType[0,1]"
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestFloat64RangeTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val         tftypes.Value
+		inclusive   bool
+		expectError bool
+	}{
+		"null": {
+			val:       tftypes.NewValue(tftypes.Number, nil),
+			inclusive: true,
+		},
+		"unknown": {
+			val:       tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			inclusive: true,
+		},
+		"in-range": {
+			val:       tftypes.NewValue(tftypes.Number, 0.5),
+			inclusive: true,
+		},
+		"at-min-inclusive": {
+			val:       tftypes.NewValue(tftypes.Number, 0),
+			inclusive: true,
+		},
+		"at-max-inclusive": {
+			val:       tftypes.NewValue(tftypes.Number, 1),
+			inclusive: true,
+		},
+		"at-min-exclusive": {
+			val:         tftypes.NewValue(tftypes.Number, 0),
+			inclusive:   false,
+			expectError: true,
+		},
+		"at-max-exclusive": {
+			val:         tftypes.NewValue(tftypes.Number, 1),
+			inclusive:   false,
+			expectError: true,
+		},
+		"below-min": {
+			val:         tftypes.NewValue(tftypes.Number, -0.1),
+			inclusive:   true,
+			expectError: true,
+		},
+		"above-max": {
+			val:         tftypes.NewValue(tftypes.Number, 1.1),
+			inclusive:   true,
+			expectError: true,
+		},
+		"wrong-type": {
+			val:         tftypes.NewValue(tftypes.String, "1"),
+			inclusive:   true,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := basetypes.NewFloat64TypeWithRange(0, 1, testCase.inclusive).Validate(context.Background(), testCase.val, path.Root("test"))
+
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", testCase.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestFloat64RangeTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val         tftypes.Value
+		expected    attr.Value
+		expectError bool
+	}{
+		"null": {
+			val:      tftypes.NewValue(tftypes.Number, nil),
+			expected: basetypes.NewFloat64Null(),
+		},
+		"unknown": {
+			val:      tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expected: basetypes.NewFloat64Unknown(),
+		},
+		"in-range": {
+			val:      tftypes.NewValue(tftypes.Number, 0.5),
+			expected: basetypes.NewFloat64Value(0.5),
+		},
+		"out-of-range": {
+			val:         tftypes.NewValue(tftypes.Number, math.Inf(1)),
+			expectError: true,
+		},
+		"above-max": {
+			val:         tftypes.NewValue(tftypes.Number, 2),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := basetypes.NewFloat64TypeWithRange(0, 1, true).ValueFromTerraform(context.Background(), testCase.val)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64RangeTypeValueFromFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val         basetypes.Float64Value
+		expectError bool
+	}{
+		"null": {
+			val: basetypes.NewFloat64Null(),
+		},
+		"unknown": {
+			val: basetypes.NewFloat64Unknown(),
+		},
+		"in-range": {
+			val: basetypes.NewFloat64Value(0.5),
+		},
+		"out-of-range": {
+			val:         basetypes.NewFloat64Value(999),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := basetypes.NewFloat64TypeWithRange(0, 1, true).ValueFromFloat64(context.Background(), testCase.val)
+
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", testCase.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestFloat64RangeTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	got := basetypes.NewFloat64TypeWithRange(0, 1, true).ValueType(context.Background())
+
+	if _, ok := got.(basetypes.Float64Value); !ok {
+		t.Errorf("expected basetypes.Float64Value, got %T", got)
+	}
+}
@@ -3,6 +3,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -23,9 +24,52 @@ type Float64Typable interface {
 
 var _ Float64Typable = Float64Type{}
 
+// PrecisionLossPolicy describes how a Float64Type should handle a Terraform
+// Number value that cannot be represented as a 64-bit float without loss of
+// accuracy.
+type PrecisionLossPolicy int
+
+const (
+	// PrecisionLossError causes precision loss to be treated as a hard
+	// error. This is the default policy and matches the framework's
+	// historical behavior.
+	PrecisionLossError PrecisionLossPolicy = iota
+
+	// PrecisionLossRoundNearest rounds the value to the nearest
+	// representable float64, with ties rounding to even.
+	PrecisionLossRoundNearest
+
+	// PrecisionLossTruncate rounds the value toward zero to the nearest
+	// representable float64.
+	PrecisionLossTruncate
+
+	// PrecisionLossWarn behaves like PrecisionLossRoundNearest, but also
+	// surfaces an AddAttributeWarning diagnostic describing the original
+	// value and the rounded result.
+	PrecisionLossWarn
+)
+
+// Float64TypeOptions configures how a Float64Type handles Terraform Number
+// values that do not fit exactly into a 64-bit float.
+type Float64TypeOptions struct {
+	// OnPrecisionLoss determines the policy applied when a known value
+	// cannot be represented as a 64-bit float without loss of accuracy.
+	// Defaults to PrecisionLossError.
+	OnPrecisionLoss PrecisionLossPolicy
+}
+
 // Float64Type is the base framework type for a floating point number.
 // Float64Value is the associated value type.
-type Float64Type struct{}
+type Float64Type struct {
+	options Float64TypeOptions
+}
+
+// NewFloat64TypeWithOptions returns a Float64Type that applies the given
+// Float64TypeOptions when converting Terraform Number values that cannot be
+// represented as a 64-bit float without loss of accuracy.
+func NewFloat64TypeWithOptions(options Float64TypeOptions) Float64Type {
+	return Float64Type{options: options}
+}
 
 // ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
 // type.
@@ -35,9 +79,13 @@ func (t Float64Type) ApplyTerraform5AttributePathStep(step tftypes.AttributePath
 
 // Equal returns true if the given type is equivalent.
 func (t Float64Type) Equal(o attr.Type) bool {
-	_, ok := o.(Float64Type)
+	other, ok := o.(Float64Type)
 
-	return ok
+	if !ok {
+		return false
+	}
+
+	return t.options == other.options
 }
 
 // String returns a human readable string of the type name.
@@ -86,15 +134,27 @@ func (t Float64Type) Validate(ctx context.Context, in tftypes.Value, path path.P
 		return diags
 	}
 
-	_, accuracy := value.Float64()
+	f, accuracy := value.Float64()
 
 	if accuracy != 0 {
-		diags.AddAttributeError(
-			path,
-			"Float64 Type Validation Error",
-			fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", value),
-		)
-		return diags
+		switch t.options.OnPrecisionLoss {
+		case PrecisionLossRoundNearest, PrecisionLossTruncate:
+			// The value will be coerced in ValueFromTerraform; no
+			// diagnostic is necessary.
+		case PrecisionLossWarn:
+			diags.AddAttributeWarning(
+				path,
+				"Float64 Precision Loss",
+				fmt.Sprintf("Value %s cannot be represented exactly as a 64-bit floating point and will be rounded to %v.", value, f),
+			)
+		default:
+			diags.AddAttributeError(
+				path,
+				"Float64 Type Validation Error",
+				fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", value),
+			)
+			return diags
+		}
 	}
 
 	return diags
@@ -127,7 +187,20 @@ func (t Float64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (
 	f, accuracy := bigF.Float64()
 
 	if accuracy != 0 {
-		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit floating point.", bigF)
+		switch t.options.OnPrecisionLoss {
+		case PrecisionLossTruncate:
+			// bigF.Float64() rounded to nearest; when that moved the value
+			// away from zero (including overflow to +/-Inf), step back to the
+			// adjacent representable value toward zero.
+			if (accuracy == big.Above && f > 0) || (accuracy == big.Below && f < 0) {
+				f = math.Nextafter(f, 0)
+			}
+		case PrecisionLossRoundNearest, PrecisionLossWarn:
+			// bigF.Float64() already rounds to the nearest
+			// representable value, with ties rounding to even.
+		default:
+			return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit floating point.", bigF)
+		}
 	}
 
 	return NewFloat64Value(f), nil
@@ -0,0 +1,213 @@
+package basetypes_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// lossyBigFloat is 2^60 + 200, an integer that cannot be represented
+// exactly as a float64: its two nearest representable doubles are
+// 2^60 (truncated, i.e. rounded toward zero) and 2^60 + 256 (rounded to
+// nearest).
+func lossyBigFloat() *big.Float {
+	i := new(big.Int).Lsh(big.NewInt(1), 60)
+	i.Add(i, big.NewInt(200))
+
+	return new(big.Float).SetPrec(200).SetInt(i)
+}
+
+func TestFloat64TypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver attr.Type
+		input    attr.Type
+		expected bool
+	}{
+		"wrong-type": {
+			receiver: basetypes.Float64Type{},
+			input:    basetypes.BigFloatType{},
+			expected: false,
+		},
+		"equal": {
+			receiver: basetypes.Float64Type{},
+			input:    basetypes.Float64Type{},
+			expected: true,
+		},
+		"different-options": {
+			receiver: basetypes.Float64Type{},
+			input: basetypes.NewFloat64TypeWithOptions(basetypes.Float64TypeOptions{
+				OnPrecisionLoss: basetypes.PrecisionLossWarn,
+			}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.receiver.Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFloat64TypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ         basetypes.Float64Type
+		val         tftypes.Value
+		expectError bool
+		expectWarn  bool
+	}{
+		"null": {
+			typ: basetypes.Float64Type{},
+			val: tftypes.NewValue(tftypes.Number, nil),
+		},
+		"unknown": {
+			typ: basetypes.Float64Type{},
+			val: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		},
+		"known-exact": {
+			typ: basetypes.Float64Type{},
+			val: tftypes.NewValue(tftypes.Number, 1.5),
+		},
+		"wrong-type": {
+			typ:         basetypes.Float64Type{},
+			val:         tftypes.NewValue(tftypes.String, "1.5"),
+			expectError: true,
+		},
+		"lossy-default-errors": {
+			typ:         basetypes.Float64Type{},
+			val:         tftypes.NewValue(tftypes.Number, lossyBigFloat()),
+			expectError: true,
+		},
+		"lossy-round-nearest-no-error": {
+			typ: basetypes.NewFloat64TypeWithOptions(basetypes.Float64TypeOptions{OnPrecisionLoss: basetypes.PrecisionLossRoundNearest}),
+			val: tftypes.NewValue(tftypes.Number, lossyBigFloat()),
+		},
+		"lossy-truncate-no-error": {
+			typ: basetypes.NewFloat64TypeWithOptions(basetypes.Float64TypeOptions{OnPrecisionLoss: basetypes.PrecisionLossTruncate}),
+			val: tftypes.NewValue(tftypes.Number, lossyBigFloat()),
+		},
+		"lossy-warn": {
+			typ:        basetypes.NewFloat64TypeWithOptions(basetypes.Float64TypeOptions{OnPrecisionLoss: basetypes.PrecisionLossWarn}),
+			val:        tftypes.NewValue(tftypes.Number, lossyBigFloat()),
+			expectWarn: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.val, path.Root("test"))
+
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", testCase.expectError, diags)
+			}
+
+			if diags.WarningsCount() > 0 != testCase.expectWarn {
+				t.Errorf("expected warning: %t, got diagnostics: %v", testCase.expectWarn, diags)
+			}
+		})
+	}
+}
+
+func TestFloat64TypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ         basetypes.Float64Type
+		val         tftypes.Value
+		expected    attr.Value
+		expectError bool
+	}{
+		"null": {
+			typ:      basetypes.Float64Type{},
+			val:      tftypes.NewValue(tftypes.Number, nil),
+			expected: basetypes.NewFloat64Null(),
+		},
+		"unknown": {
+			typ:      basetypes.Float64Type{},
+			val:      tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expected: basetypes.NewFloat64Unknown(),
+		},
+		"known-exact": {
+			typ:      basetypes.Float64Type{},
+			val:      tftypes.NewValue(tftypes.Number, 1.5),
+			expected: basetypes.NewFloat64Value(1.5),
+		},
+		"lossy-default-errors": {
+			typ:         basetypes.Float64Type{},
+			val:         tftypes.NewValue(tftypes.Number, lossyBigFloat()),
+			expectError: true,
+		},
+		"lossy-round-nearest": {
+			typ:      basetypes.NewFloat64TypeWithOptions(basetypes.Float64TypeOptions{OnPrecisionLoss: basetypes.PrecisionLossRoundNearest}),
+			val:      tftypes.NewValue(tftypes.Number, lossyBigFloat()),
+			expected: basetypes.NewFloat64Value(1152921504606847232),
+		},
+		"lossy-truncate": {
+			typ:      basetypes.NewFloat64TypeWithOptions(basetypes.Float64TypeOptions{OnPrecisionLoss: basetypes.PrecisionLossTruncate}),
+			val:      tftypes.NewValue(tftypes.Number, lossyBigFloat()),
+			expected: basetypes.NewFloat64Value(1152921504606846976),
+		},
+		"lossy-warn": {
+			typ:      basetypes.NewFloat64TypeWithOptions(basetypes.Float64TypeOptions{OnPrecisionLoss: basetypes.PrecisionLossWarn}),
+			val:      tftypes.NewValue(tftypes.Number, lossyBigFloat()),
+			expected: basetypes.NewFloat64Value(1152921504606847232),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.val)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64TypeValueType(t *testing.T) {
+	t.Parallel()
+
+	got := basetypes.Float64Type{}.ValueType(context.Background())
+
+	if _, ok := got.(basetypes.Float64Value); !ok {
+		t.Errorf("expected basetypes.Float64Value, got %T", got)
+	}
+}
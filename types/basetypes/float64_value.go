@@ -0,0 +1,284 @@
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Float64Valuable extends attr.Value for types that can be converted to
+// Float64Value.
+type Float64Valuable interface {
+	attr.Value
+
+	// ToFloat64Value should convert the value type to a Float64Value.
+	ToFloat64Value(ctx context.Context) (Float64Value, diag.Diagnostics)
+}
+
+// Float64ValuableWithSemanticEquals extends Float64Valuable with semantic
+// equality logic. The framework itself does not call Float64SemanticEquals
+// during plan or state comparison.
+type Float64ValuableWithSemanticEquals interface {
+	Float64Valuable
+
+	// Float64SemanticEquals should return true if the given value is
+	// semantically equal to the current value.
+	Float64SemanticEquals(ctx context.Context, newValuable Float64Valuable) (bool, diag.Diagnostics)
+}
+
+var _ Float64Valuable = Float64Value{}
+
+// NewFloat64Null creates a Float64Value with a null value. Determine whether
+// the value is null via the Float64Value type IsNull method.
+func NewFloat64Null() Float64Value {
+	return Float64Value{state: attr.ValueStateNull}
+}
+
+// NewFloat64Unknown creates a Float64Value with an unknown value. Determine
+// whether the value is unknown via the Float64Value type IsUnknown method.
+func NewFloat64Unknown() Float64Value {
+	return Float64Value{state: attr.ValueStateUnknown}
+}
+
+// NewFloat64Value creates a Float64Value with a known value. Access the value
+// via the Float64Value type ValueFloat64 method.
+func NewFloat64Value(value float64) Float64Value {
+	return Float64Value{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// NewFloat64PointerValue creates a Float64Value with a null value if nil, or
+// a known value. Access the value via the Float64Value type ValueFloat64Pointer
+// method.
+func NewFloat64PointerValue(value *float64) Float64Value {
+	if value == nil {
+		return NewFloat64Null()
+	}
+
+	return NewFloat64Value(*value)
+}
+
+// Float64Value represents a 64-bit floating point value, which can be null,
+// unknown, or a known value.
+type Float64Value struct {
+	state attr.ValueState
+	value float64
+}
+
+// Type returns a Float64Type.
+func (v Float64Value) Type(_ context.Context) attr.Type {
+	return Float64Type{}
+}
+
+// ToTerraformValue returns the data contained in the Float64Value as a
+// tftypes.Value.
+func (v Float64Value) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	switch v.state {
+	case attr.ValueStateKnown:
+		if err := tftypes.ValidateValue(tftypes.Number, v.value); err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.Number, new(big.Float).SetFloat64(v.value)), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tftypes.Number, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Float64Value state in ToTerraformValue: %s", v.state))
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Float64Value) Equal(o attr.Value) bool {
+	other, ok := o.(Float64Value)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return v.value == other.value
+}
+
+// IsNull returns true if the Value is not set, or is explicitly set to null.
+func (v Float64Value) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Value is not yet known.
+func (v Float64Value) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+// String returns a human readable representation of the value.
+func (v Float64Value) String() string {
+	if v.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if v.IsNull() {
+		return attr.NullValueString
+	}
+
+	return fmt.Sprintf("%v", v.value)
+}
+
+// ValueFloat64 returns the known float64 value. If Float64Value is null or
+// unknown, returns 0.0.
+func (v Float64Value) ValueFloat64() float64 {
+	return v.value
+}
+
+// ValueFloat64Pointer returns a pointer to the known float64 value, nil for
+// a null value, or a pointer to 0.0 for an unknown value.
+func (v Float64Value) ValueFloat64Pointer() *float64 {
+	if v.IsNull() {
+		return nil
+	}
+
+	value := v.value
+
+	return &value
+}
+
+// ToFloat64Value returns Float64Value.
+func (v Float64Value) ToFloat64Value(_ context.Context) (Float64Value, diag.Diagnostics) {
+	return v, nil
+}
+
+// Float64SemanticEquals compares for exact equality. Types wanting tolerance-
+// based comparison should use Float64ValueWithSemanticEquals or implement
+// Float64ValuableWithSemanticEquals directly.
+func (v Float64Value) Float64SemanticEquals(_ context.Context, newValuable Float64Valuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Float64Value)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\nGot Value Type: %T", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	return v.Equal(newValue), diags
+}
+
+var _ Float64ValuableWithSemanticEquals = Float64ValueWithSemanticEquals{}
+
+// Float64SemanticEqualityTolerance configures how much two Float64Values are
+// allowed to differ by and still be considered semantically equal. At least
+// one of AbsoluteEpsilon or RelativeEpsilon should be set to a positive
+// value; a zero tolerance falls back to exact comparison.
+type Float64SemanticEqualityTolerance struct {
+	// AbsoluteEpsilon is the maximum allowed difference between two values,
+	// regardless of their magnitude.
+	AbsoluteEpsilon float64
+
+	// RelativeEpsilon is the maximum allowed difference between two values,
+	// expressed as a fraction of the larger value's magnitude. Useful when
+	// comparing values whose scale varies widely.
+	RelativeEpsilon float64
+}
+
+// Float64ValueWithSemanticEquals is a Float64Value whose Float64SemanticEquals
+// method reports it as equal to another Float64Value when their difference
+// falls within the configured Float64SemanticEqualityTolerance (e.g.
+// 0.1 + 0.2 versus 0.3). Equal remains an exact comparison, and the framework
+// itself does not call Float64SemanticEquals during plan or state comparison.
+type Float64ValueWithSemanticEquals struct {
+	Float64Value
+
+	tolerance Float64SemanticEqualityTolerance
+}
+
+// NewFloat64ValueWithSemanticEquals creates a Float64ValueWithSemanticEquals
+// with a known value, applying the given tolerance during semantic equality
+// checks.
+func NewFloat64ValueWithSemanticEquals(value float64, tolerance Float64SemanticEqualityTolerance) Float64ValueWithSemanticEquals {
+	return Float64ValueWithSemanticEquals{
+		Float64Value: NewFloat64Value(value),
+		tolerance:    tolerance,
+	}
+}
+
+// Equal returns true if the given value is equivalent, ignoring tolerance.
+func (v Float64ValueWithSemanticEquals) Equal(o attr.Value) bool {
+	other, ok := o.(Float64ValueWithSemanticEquals)
+
+	if !ok {
+		return false
+	}
+
+	return v.Float64Value.Equal(other.Float64Value)
+}
+
+// Float64SemanticEquals returns true if the given value is within the
+// configured tolerance of the current value.
+func (v Float64ValueWithSemanticEquals) Float64SemanticEquals(ctx context.Context, newValuable Float64Valuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Float64ValueWithSemanticEquals)
+
+	if !ok {
+		plainValue, plainOk := newValuable.(Float64Value)
+
+		if !plainOk {
+			diags.AddError(
+				"Semantic Equality Check Error",
+				"An unexpected value type was received while performing semantic equality checks. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Expected Value Type: %T\nGot Value Type: %T", v, newValuable),
+			)
+
+			return false, diags
+		}
+
+		newValue = Float64ValueWithSemanticEquals{Float64Value: plainValue, tolerance: v.tolerance}
+	}
+
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return v.Float64Value.Equal(newValue.Float64Value), diags
+	}
+
+	old, new := v.ValueFloat64(), newValue.ValueFloat64()
+
+	if old == new {
+		return true, diags
+	}
+
+	diff := math.Abs(old - new)
+
+	if v.tolerance.AbsoluteEpsilon > 0 && diff <= v.tolerance.AbsoluteEpsilon {
+		return true, diags
+	}
+
+	if v.tolerance.RelativeEpsilon > 0 {
+		largest := math.Max(math.Abs(old), math.Abs(new))
+
+		if largest > 0 && diff/largest <= v.tolerance.RelativeEpsilon {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
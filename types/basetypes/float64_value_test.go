@@ -0,0 +1,199 @@
+package basetypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFloat64ValueEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver basetypes.Float64Value
+		input    attr.Value
+		expected bool
+	}{
+		"wrong-type": {
+			receiver: basetypes.NewFloat64Value(1.5),
+			input:    basetypes.NewBigFloatValue(nil),
+			expected: false,
+		},
+		"null-null":       {receiver: basetypes.NewFloat64Null(), input: basetypes.NewFloat64Null(), expected: true},
+		"unknown-unknown": {receiver: basetypes.NewFloat64Unknown(), input: basetypes.NewFloat64Unknown(), expected: true},
+		"known-known-equal": {
+			receiver: basetypes.NewFloat64Value(1.5),
+			input:    basetypes.NewFloat64Value(1.5),
+			expected: true,
+		},
+		"known-known-different": {
+			receiver: basetypes.NewFloat64Value(1.5),
+			input:    basetypes.NewFloat64Value(2.5),
+			expected: false,
+		},
+		"known-null": {
+			receiver: basetypes.NewFloat64Value(1.5),
+			input:    basetypes.NewFloat64Null(),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.receiver.Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFloat64ValueFloat64SemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver    basetypes.Float64Value
+		input       basetypes.Float64Valuable
+		expected    bool
+		expectError bool
+	}{
+		"equal": {
+			receiver: basetypes.NewFloat64Value(1.5),
+			input:    basetypes.NewFloat64Value(1.5),
+			expected: true,
+		},
+		"different": {
+			receiver: basetypes.NewFloat64Value(1.5),
+			input:    basetypes.NewFloat64Value(1.50001),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.receiver.Float64SemanticEquals(context.Background(), testCase.input)
+
+			if diags.HasError() != testCase.expectError {
+				t.Fatalf("expected error: %t, got diagnostics: %v", testCase.expectError, diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+// runtimeSum adds a and b at runtime, preventing the compiler from constant
+// folding 0.1 + 0.2 into the exact value 0.3 and losing the IEEE-754
+// representation drift (0.30000000000000004) these tests exercise.
+//
+//go:noinline
+func runtimeSum(a, b float64) float64 {
+	return a + b
+}
+
+func TestFloat64ValueWithSemanticEqualsFloat64SemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver basetypes.Float64ValueWithSemanticEquals
+		input    basetypes.Float64Valuable
+		expected bool
+	}{
+		"exact-equal": {
+			receiver: basetypes.NewFloat64ValueWithSemanticEquals(0.3, basetypes.Float64SemanticEqualityTolerance{}),
+			input:    basetypes.NewFloat64ValueWithSemanticEquals(0.3, basetypes.Float64SemanticEqualityTolerance{}),
+			expected: true,
+		},
+		"no-tolerance-different": {
+			receiver: basetypes.NewFloat64ValueWithSemanticEquals(runtimeSum(0.1, 0.2), basetypes.Float64SemanticEqualityTolerance{}),
+			input:    basetypes.NewFloat64ValueWithSemanticEquals(0.3, basetypes.Float64SemanticEqualityTolerance{}),
+			expected: false,
+		},
+		"absolute-epsilon-within": {
+			receiver: basetypes.NewFloat64ValueWithSemanticEquals(runtimeSum(0.1, 0.2), basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 1e-9}),
+			input:    basetypes.NewFloat64ValueWithSemanticEquals(0.3, basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 1e-9}),
+			expected: true,
+		},
+		"absolute-epsilon-at-boundary": {
+			receiver: basetypes.NewFloat64ValueWithSemanticEquals(1.0, basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 0.5}),
+			input:    basetypes.NewFloat64ValueWithSemanticEquals(1.5, basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 0.5}),
+			expected: true,
+		},
+		"absolute-epsilon-just-outside": {
+			receiver: basetypes.NewFloat64ValueWithSemanticEquals(1.0, basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 0.5}),
+			input:    basetypes.NewFloat64ValueWithSemanticEquals(1.500001, basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 0.5}),
+			expected: false,
+		},
+		"relative-epsilon-within": {
+			receiver: basetypes.NewFloat64ValueWithSemanticEquals(1000000, basetypes.Float64SemanticEqualityTolerance{RelativeEpsilon: 0.01}),
+			input:    basetypes.NewFloat64ValueWithSemanticEquals(1005000, basetypes.Float64SemanticEqualityTolerance{RelativeEpsilon: 0.01}),
+			expected: true,
+		},
+		"relative-epsilon-just-outside": {
+			receiver: basetypes.NewFloat64ValueWithSemanticEquals(1000000, basetypes.Float64SemanticEqualityTolerance{RelativeEpsilon: 0.01}),
+			input:    basetypes.NewFloat64ValueWithSemanticEquals(1020000, basetypes.Float64SemanticEqualityTolerance{RelativeEpsilon: 0.01}),
+			expected: false,
+		},
+		"known-vs-null": {
+			receiver: basetypes.NewFloat64ValueWithSemanticEquals(1, basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 100}),
+			input:    basetypes.NewFloat64Null(),
+			expected: false,
+		},
+		"plain-float64-value-fallback": {
+			receiver: basetypes.NewFloat64ValueWithSemanticEquals(1.0, basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 0.5}),
+			input:    basetypes.NewFloat64Value(1.4),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.receiver.Float64SemanticEquals(context.Background(), testCase.input)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %s", diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFloat64ValueWithSemanticEqualsEqual(t *testing.T) {
+	t.Parallel()
+
+	// Equal must ignore tolerance and remain a strict comparison, so that
+	// Float64SemanticEquals (not Equal) is the only path that suppresses
+	// tolerance-based diffs.
+	a := basetypes.NewFloat64ValueWithSemanticEquals(1.0, basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 100})
+	b := basetypes.NewFloat64ValueWithSemanticEquals(1.4, basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 100})
+
+	if a.Equal(b) {
+		t.Error("expected Equal to ignore tolerance and report false for differing values")
+	}
+
+	c := basetypes.NewFloat64ValueWithSemanticEquals(1.0, basetypes.Float64SemanticEqualityTolerance{AbsoluteEpsilon: 100})
+
+	if !a.Equal(c) {
+		t.Error("expected Equal to report true for identical values")
+	}
+}